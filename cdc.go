@@ -0,0 +1,117 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"hash/crc32"
+)
+
+const cdcWindowSize = 64 // bytes in the rolling-hash sliding window
+
+const (
+	cdcMinChunkSize    = 256 * 1024
+	cdcTargetChunkSize = 1 * 1024 * 1024
+	cdcMaxChunkSize    = 4 * 1024 * 1024
+	cdcBoundaryMask    = cdcTargetChunkSize - 1 // low log2(targetChunkSize) bits
+)
+
+var (
+	cdcHashTable [256]uint32 // per-byte contribution to the rolling hash
+	cdcOutTable  [256]uint32 // cdcHashTable[b] rotated left by cdcWindowSize bits, for byte eviction
+)
+
+func init() {
+	// A simple deterministic per-byte hash (splitmix64-style mixing); the
+	// rolling hash only needs well-distributed, reproducible constants, not
+	// cryptographic strength.
+	for b := 0; b < 256; b++ {
+		h := uint64(b+1) * 0x9E3779B97F4A7C15
+		h ^= h >> 30
+		h *= 0xBF58476D1CE4E5B9
+		h ^= h >> 27
+		cdcHashTable[b] = uint32(h >> 32)
+	}
+	for b := 0; b < 256; b++ {
+		cdcOutTable[b] = rotl32(cdcHashTable[b], cdcWindowSize)
+	}
+}
+
+func rotl32(x uint32, k int) uint32 {
+	k &= 31
+	return x<<k | x>>(32-k)
+} //rotl32()
+
+type cdcChunk struct {
+	offset int64
+	length int64
+	crc    string
+} //cdcChunk struct
+
+// cdcState incrementally splits a byte stream into content-defined chunks as
+// it's fed buffer-by-buffer, using a buzhash-style rolling hash over a
+// cdcWindowSize-byte window: a boundary falls wherever the low bits of the
+// rolling hash match cdcBoundaryMask, subject to cdcMinChunkSize/
+// cdcMaxChunkSize bounds. It's driven from crcReaderSequential's existing
+// read loop so the whole-file CRC and the chunk CRCs come from one pass over
+// the file.
+type cdcState struct {
+	window       [cdcWindowSize]byte
+	windowPos    int
+	windowFilled int
+	rollingHash  uint32
+
+	chunks        []cdcChunk
+	chunkOffset   int64
+	chunkLen      int64
+	chunkChecksum uint32
+} //cdcState struct
+
+// update folds the next slice of file bytes (as handed to crc32.Update) into
+// the rolling hash and chunk CRCs.
+func (s *cdcState) update(data []byte) {
+	pendingStart := 0
+	for i, b := range data {
+		s.rollingHash = rotl32(s.rollingHash, 1) ^ cdcHashTable[b]
+		if s.windowFilled < cdcWindowSize {
+			s.windowFilled++
+		} else {
+			s.rollingHash ^= cdcOutTable[s.window[s.windowPos]]
+		}
+		s.window[s.windowPos] = b
+		s.windowPos = (s.windowPos + 1) % cdcWindowSize
+		s.chunkLen++
+
+		atBoundary := s.chunkLen >= cdcMaxChunkSize ||
+			(s.chunkLen >= cdcMinChunkSize && s.windowFilled == cdcWindowSize && s.rollingHash&cdcBoundaryMask == cdcBoundaryMask)
+		if atBoundary {
+			s.chunkChecksum = crc32.Update(s.chunkChecksum, g_crc32cTable, data[pendingStart:i+1])
+			pendingStart = i + 1
+			s.flush()
+		}
+	} //for
+
+	if pendingStart < len(data) {
+		s.chunkChecksum = crc32.Update(s.chunkChecksum, g_crc32cTable, data[pendingStart:])
+	}
+} //update()
+
+func (s *cdcState) flush() {
+	checksumBuf := make([]byte, crc32.Size)
+	binary.BigEndian.PutUint32(checksumBuf, s.chunkChecksum)
+	s.chunks = append(s.chunks, cdcChunk{
+		offset: s.chunkOffset,
+		length: s.chunkLen,
+		crc:    base64.StdEncoding.EncodeToString(checksumBuf),
+	})
+	s.chunkOffset += s.chunkLen
+	s.chunkLen = 0
+	s.chunkChecksum = 0
+} //flush()
+
+// finish flushes any trailing partial chunk and returns the final chunk list.
+func (s *cdcState) finish() []cdcChunk {
+	if s.chunkLen > 0 {
+		s.flush()
+	}
+	return s.chunks
+} //finish()
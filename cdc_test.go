@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"hash/crc32"
+	"testing"
+)
+
+func pseudoRandomBytes(size int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i * 2246822519 >> 11)
+	}
+	return data
+}
+
+func chunkData(data []byte, feedSize int) []cdcChunk {
+	cdc := &cdcState{}
+	for off := 0; off < len(data); off += feedSize {
+		end := off + feedSize
+		if end > len(data) {
+			end = len(data)
+		}
+		cdc.update(data[off:end])
+	}
+	return cdc.finish()
+}
+
+// TestCDCChunkingMatchesDirectHash drives cdcState over real data and checks
+// that the chunks it reports are contiguous, within the configured size
+// bounds, and that each chunk's CRC matches a direct hash/crc32 pass over
+// that chunk's own bytes.
+func TestCDCChunkingMatchesDirectHash(t *testing.T) {
+	const size = 8*1024*1024 + 777 // a few MB so several boundaries fire
+	data := pseudoRandomBytes(size)
+
+	chunks := chunkData(data, 64*1024)
+	if len(chunks) < 2 {
+		t.Fatalf("expected multiple chunks for %d bytes, got %d", size, len(chunks))
+	}
+
+	var total int64
+	for i, c := range chunks {
+		if c.offset != total {
+			t.Fatalf("chunk %d: offset = %d, want %d", i, c.offset, total)
+		}
+		if c.length <= 0 {
+			t.Fatalf("chunk %d: length = %d", i, c.length)
+		}
+		if c.length > cdcMaxChunkSize {
+			t.Fatalf("chunk %d: length %d exceeds cdcMaxChunkSize", i, c.length)
+		}
+		if isLast := i == len(chunks)-1; !isLast && c.length < cdcMinChunkSize {
+			t.Fatalf("chunk %d: length %d below cdcMinChunkSize", i, c.length)
+		}
+
+		want := crc32.Checksum(data[c.offset:c.offset+c.length], g_crc32cTable)
+		decoded, err := base64.StdEncoding.DecodeString(c.crc)
+		if err != nil {
+			t.Fatalf("chunk %d: decoding crc: %v", i, err)
+		}
+		if got := binary.BigEndian.Uint32(decoded); got != want {
+			t.Fatalf("chunk %d: crc = %08x, want %08x", i, got, want)
+		}
+
+		total += c.length
+	}
+	if total != int64(len(data)) {
+		t.Fatalf("total chunked length = %d, want %d", total, len(data))
+	}
+}
+
+// TestCDCChunkingIndependentOfFeedSize checks that content-defined chunking
+// lives up to its name: the resulting chunk boundaries must depend only on
+// file content, not on how the caller happens to split it across update()
+// calls (CRCReader feeds it one read-buffer at a time, but nothing else
+// should rely on that).
+func TestCDCChunkingIndependentOfFeedSize(t *testing.T) {
+	data := pseudoRandomBytes(2 * 1024 * 1024)
+
+	fedSmall := chunkData(data, 4096)
+	fedWhole := chunkData(data, len(data))
+
+	if len(fedSmall) != len(fedWhole) {
+		t.Fatalf("chunk count depends on feed size: %d (4KiB feeds) vs %d (single feed)", len(fedSmall), len(fedWhole))
+	}
+	for i := range fedSmall {
+		if fedSmall[i] != fedWhole[i] {
+			t.Fatalf("chunk %d depends on feed size: %+v vs %+v", i, fedSmall[i], fedWhole[i])
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"fmt"
+	"hash/crc32"
+	"os"
+)
+
+const gf2Dim = 32 // width in bits of the CRC32C state vector
+
+// gf2MatrixTimes multiplies a GF(2) matrix (given as its 32 column vectors)
+// by a 32-bit column vector, i.e. XORs together the columns selected by the
+// set bits of vec.
+func gf2MatrixTimes(mat *[gf2Dim]uint32, vec uint32) uint32 {
+	var sum uint32
+	for i := 0; vec != 0; i++ {
+		if vec&1 != 0 {
+			sum ^= mat[i]
+		}
+		vec >>= 1
+	}
+	return sum
+} //gf2MatrixTimes()
+
+// gf2MatrixSquare computes square = mat * mat over GF(2).
+func gf2MatrixSquare(square, mat *[gf2Dim]uint32) {
+	for n := 0; n < gf2Dim; n++ {
+		square[n] = gf2MatrixTimes(mat, mat[n])
+	}
+} //gf2MatrixSquare()
+
+// crc32cCombine merges crc1 (the CRC32C of some byte range) and crc2 (the
+// CRC32C of the len2 bytes immediately following it) into the CRC32C of
+// their concatenation, without re-reading either range. This is the classic
+// zlib crc32_combine algorithm: CRC32C advances as a linear map over GF(2),
+// so a len2-byte shift can be built by squaring the 1-bit shift matrix
+// (whose columns are the reflected Castagnoli polynomial and the identity
+// shifts) up through powers of two and multiplying in the ones selected by
+// the bits of len2.
+func crc32cCombine(crc1, crc2 uint32, len2 int64) uint32 {
+	if len2 <= 0 {
+		return crc1
+	}
+
+	var odd, even [gf2Dim]uint32
+
+	odd[0] = crc32.Castagnoli // reflected CRC32C polynomial: column 0 of the 1-bit shift matrix
+	row := uint32(1)
+	for n := 1; n < gf2Dim; n++ {
+		odd[n] = row
+		row <<= 1
+	}
+
+	gf2MatrixSquare(&even, &odd) // even: 2-bit shift
+	gf2MatrixSquare(&odd, &even) // odd: 4-bit shift
+
+	for {
+		gf2MatrixSquare(&even, &odd) // even: next power-of-two-byte shift
+		if len2&1 != 0 {
+			crc1 = gf2MatrixTimes(&even, crc1)
+		}
+		len2 >>= 1
+		if len2 == 0 {
+			break
+		}
+
+		gf2MatrixSquare(&odd, &even) // odd: next power-of-two-byte shift
+		if len2&1 != 0 {
+			crc1 = gf2MatrixTimes(&odd, crc1)
+		}
+		len2 >>= 1
+		if len2 == 0 {
+			break
+		}
+	}
+
+	return crc1 ^ crc2
+} //crc32cCombine()
+
+// combineSanityCheck verifies crc32cCombine against a direct hash/crc32
+// computation, mirroring the startup self-check in sanityCheck().
+func combineSanityCheck() {
+	const dataA = "Hello, "
+	const dataB = "World! This is mass-crc32c."
+
+	crcA := crc32.Update(0, g_crc32cTable, []byte(dataA))
+	crcB := crc32.Update(0, g_crc32cTable, []byte(dataB))
+	combined := crc32cCombine(crcA, crcB, int64(len(dataB)))
+
+	expected := crc32.Update(0, g_crc32cTable, []byte(dataA+dataB))
+	if combined != expected {
+		fmt.Fprintf(os.Stderr, "Combine Sanity Check failed! [expected: %08x, calculated: %08x]. Terminating.\n",
+			expected, combined)
+		os.Exit(2)
+	}
+} //combineSanityCheck()
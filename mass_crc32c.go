@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/base64"
 	"encoding/binary"
@@ -10,11 +11,13 @@ import (
 	"hash/crc32"
 	"io"
 	"log"
-	"math"
 	"os"
 	"path/filepath"
 	"runtime"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 	"unsafe"
 
@@ -23,48 +26,144 @@ import (
 ) //import
 
 type job struct {
-	path string
-	size int64
+	path        string
+	size        int64
+	expectedCRC string // base64 CRC from a prior run; only set in -verify mode
 } //job struct
 
 type jobStat struct {
-	bytesProcessed int64
-	filesProcessed int64
+	bytesProcessed atomic.Int64
+	filesProcessed atomic.Int64
 }
 
 var (
-	g_jobQueue    chan job
-	g_crc32cTable *crc32.Table
+	g_jobQueue        chan job
+	g_crc32cTable     *crc32.Table
+	g_humanSizes      bool
+	g_progressEnabled bool
+	g_verifyEnabled   bool
+	g_splitParts      int
+	g_splitMinBytes   int64
+	g_cdcEnabled      bool
+
+	g_progressMutex   sync.Mutex // guards stderr writes/overwrites of the progress line below
+	g_lastProgressLen int
+
+	g_verifyOK      atomic.Int64
+	g_verifyFailed  atomic.Int64
+	g_verifyMissing atomic.Int64
 )
 
+const humanSizeColumnWidth = 11 // stable column width for "-h" sizes, e.g. "1.23 MiB"; the widest is "1024.00 KiB"
+
+var errSizeMismatch = errors.New("fileInfoSize != processedSize")
+
+// writeStderr writes msg to stderr, clearing the live progress line first so
+// it isn't clobbered by partial overwrites.
+func writeStderr(msg string) {
+	if !g_progressEnabled {
+		os.Stderr.WriteString(msg)
+		return
+	}
+
+	g_progressMutex.Lock()
+	clearProgressLineLocked()
+	os.Stderr.WriteString(msg)
+	g_progressMutex.Unlock()
+} //writeStderr()
+
 func printErr(path string, err error) {
-	os.Stderr.WriteString("CRC error: '" + path + "' : " + err.Error() + "\n")
+	writeStderr("CRC error: '" + path + "' : " + err.Error() + "\n")
 } //printErr()
 
-func CRCReader(j job, buffer []byte, bufferSize int) (string, error) {
+// clearProgressLineLocked blanks out the currently displayed progress line.
+// Callers must hold g_progressMutex.
+func clearProgressLineLocked() {
+	if g_lastProgressLen > 0 {
+		os.Stderr.WriteString("\r" + strings.Repeat(" ", g_lastProgressLen) + "\r")
+		g_lastProgressLen = 0
+	}
+} //clearProgressLineLocked()
+
+// writeProgressLine redraws the single-line stderr progress status with '\r',
+// padding over whatever was previously displayed so no stale tail remains.
+func writeProgressLine(line string) {
+	g_progressMutex.Lock()
+	defer g_progressMutex.Unlock()
+
+	if pad := g_lastProgressLen - len(line); pad > 0 {
+		line += strings.Repeat(" ", pad)
+	}
+	os.Stderr.WriteString("\r" + line)
+	g_lastProgressLen = len(line)
+} //writeProgressLine()
+
+// humanSizeUnits are the IEC binary unit prefixes humanizeIBytes cycles
+// through, from KiB up to EiB (the largest that fits in an int64 byte count).
+const humanSizeUnits = "KMGTPE"
+
+// humanizeIBytes renders size using IEC binary units (KiB..EiB), two-digit
+// precision, right-padded to humanSizeColumnWidth.
+func humanizeIBytes(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%-*s", humanSizeColumnWidth, fmt.Sprintf("%d B", size))
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit && exp < len(humanSizeUnits)-1; n /= unit {
+		div *= unit
+		exp++
+	}
+	str := fmt.Sprintf("%.2f %ciB", float64(size)/float64(div), humanSizeUnits[exp])
+	return fmt.Sprintf("%-*s", humanSizeColumnWidth, str)
+} //humanizeIBytes()
+
+// CRCReader computes the CRC32C (and size) of j.path, splitting large files
+// across g_splitParts goroutines and recombining when eligible. -cdc always
+// goes through crcReaderSequential, since content-defined chunking needs a
+// single ordered pass over the file; main() rejects -cdc combined with
+// -split outright rather than silently ignoring one of them.
+func CRCReader(j job, buffer []byte, bufferSize int) (string, int64, []cdcChunk, error) {
+	if !g_cdcEnabled && g_splitParts > 1 && j.size >= g_splitMinBytes {
+		return crcReaderSplit(j, bufferSize)
+	}
+	return crcReaderSequential(j, buffer, bufferSize)
+} //CRCReader()
+
+func crcReaderSequential(j job, buffer []byte, bufferSize int) (string, int64, []cdcChunk, error) {
 	file, err := os.Open(j.path)
 	if err != nil {
-		printErr(j.path, err)
-		return "", err
+		return "", 0, nil, err
 	}
 	defer file.Close()
 
 	checksum := uint32(0)
 	processedSize := int64(0)
 
+	var cdc *cdcState
+	if g_cdcEnabled {
+		cdc = &cdcState{}
+	}
+
 	for {
 		switch n, err := file.Read(buffer); err {
 		case nil: // runs many times
 			if n == bufferSize {
 				processedSize += int64(bufferSize)
 				checksum = crc32.Update(checksum, g_crc32cTable, buffer)
+				if cdc != nil {
+					cdc.update(buffer)
+				}
 			} else {
 				processedSize += int64(n)
 				checksum = crc32.Update(checksum, g_crc32cTable, buffer[:n])
+				if cdc != nil {
+					cdc.update(buffer[:n])
+				}
 			}
 		case io.EOF: // runs once
 			if j.size != processedSize {
-				return "", errors.New("fileInfoSize != processedSize")
+				return "", processedSize, nil, errSizeMismatch
 			}
 
 			const checksumByteSize = crc32.Size
@@ -76,45 +175,191 @@ func CRCReader(j job, buffer []byte, bufferSize int) (string, error) {
 			binary.BigEndian.PutUint32(checksumBufferSlice, checksum)
 			base64.StdEncoding.Encode(encodedBufferSlice, checksumBufferSlice)
 
-			return string(encodedBufferSlice), nil
+			var chunks []cdcChunk
+			if cdc != nil {
+				chunks = cdc.finish()
+			}
+
+			return string(encodedBufferSlice), processedSize, chunks, nil
 		default: // should never run
-			return "ERROR!", err
+			return "ERROR!", processedSize, nil, err
 		} //switch
 	} //for
-} //CRCReader()
+} //crcReaderSequential()
+
+// crcReaderSplit hashes j.path as g_splitParts contiguous byte ranges in
+// parallel, each with its own buffer and ReadAt-based reader, then recombines
+// the partial CRC32Cs with crc32cCombine into a result bit-identical to
+// crcReaderSequential. It never runs under -cdc (see CRCReader).
+func crcReaderSplit(j job, bufferSize int) (string, int64, []cdcChunk, error) {
+	file, err := os.Open(j.path)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	defer file.Close()
+
+	parts := g_splitParts
+	if int64(parts) > j.size {
+		parts = int(j.size)
+	}
+	if parts < 1 {
+		parts = 1
+	}
+
+	chunkSize := j.size / int64(parts)
+	results := make([]chunkResult, parts)
+
+	var waitGroup sync.WaitGroup
+	waitGroup.Add(parts)
+	for i := 0; i < parts; i++ {
+		offset := int64(i) * chunkSize
+		length := chunkSize
+		if i == parts-1 {
+			length = j.size - offset // last chunk absorbs the remainder
+		}
+
+		go func(i int, offset, length int64) {
+			defer waitGroup.Done()
+			results[i] = hashFileRange(file, offset, length, bufferSize)
+		}(i, offset, length)
+	}
+	waitGroup.Wait()
+
+	var checksum uint32
+	var processedSize int64
+	for _, r := range results {
+		if r.err != nil {
+			return "", processedSize, nil, r.err
+		}
+		checksum = crc32cCombine(checksum, r.checksum, r.size)
+		processedSize += r.size
+	}
+
+	if j.size != processedSize {
+		return "", processedSize, nil, errSizeMismatch
+	}
+
+	const checksumByteSize = crc32.Size
+	const checksumBase64Size = (checksumByteSize + 2) / 3 * 4
+
+	checksumBufferSlice := make([]byte, checksumByteSize)
+	encodedBufferSlice := make([]byte, checksumBase64Size)
+
+	binary.BigEndian.PutUint32(checksumBufferSlice, checksum)
+	base64.StdEncoding.Encode(encodedBufferSlice, checksumBufferSlice)
+
+	return string(encodedBufferSlice), processedSize, nil, nil
+} //crcReaderSplit()
+
+type chunkResult struct {
+	checksum uint32
+	size     int64
+	err      error
+} //chunkResult struct
+
+// hashFileRange computes the CRC32C of the [offset, offset+length) byte
+// range of file using its own buffer, reading via ReadAt so it can run
+// concurrently with sibling ranges sharing the same *os.File. A short read
+// (file shorter than the range's declared length, e.g. truncated since the
+// job was enqueued) is reported as a partial chunkResult rather than an
+// error, so crcReaderSplit can fold it into the same size-mismatch check
+// crcReaderSequential does instead of surfacing a bare io.EOF.
+func hashFileRange(file *os.File, offset, length int64, bufferSize int) chunkResult {
+	buffer := make([]byte, bufferSize)
+	checksum := uint32(0)
+	remaining := length
+
+	for remaining > 0 {
+		readSize := int64(len(buffer))
+		if readSize > remaining {
+			readSize = remaining
+		}
+
+		n, err := file.ReadAt(buffer[:readSize], offset)
+		if n > 0 {
+			checksum = crc32.Update(checksum, g_crc32cTable, buffer[:n])
+			offset += int64(n)
+			remaining -= int64(n)
+		}
+		if err != nil {
+			if err == io.EOF {
+				break // short read; let the caller's size check report it
+			}
+			return chunkResult{err: err}
+		}
+	}
+
+	return chunkResult{checksum: checksum, size: length - remaining}
+} //hashFileRange()
+
+// verifyResult compares a -verify job's recomputed CRC/size against the
+// expected values parsed from the manifest and tallies the outcome.
+func verifyResult(j job, crc string, processedSize int64, err error) {
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		writeStderr("MISSING: " + j.path + "\n")
+		g_verifyMissing.Add(1)
+	case errors.Is(err, errSizeMismatch):
+		writeStderr(fmt.Sprintf("SIZE: %s (expected %016x, got %016x)\n", j.path, j.size, processedSize))
+		g_verifyFailed.Add(1)
+	case err != nil:
+		printErr(j.path, err)
+		g_verifyFailed.Add(1)
+	case crc != j.expectedCRC:
+		writeStderr(fmt.Sprintf("MISMATCH: %s (expected %s, got %s)\n", j.path, j.expectedCRC, crc))
+		g_verifyFailed.Add(1)
+	default:
+		g_verifyOK.Add(1)
+	}
+} //verifyResult()
 
 func fileHandler(jobId int, bufferSizeKB int, jobStats []jobStat) error {
 	fileReadBufferSize := 1024 * bufferSizeKB
 	fileReadBuffer := make([]byte, fileReadBufferSize)
 	stdoutBuffer := bytes.Buffer{}
 	batchCounter := uint8(0) // batches of 256
-	localJobStat := jobStat{}
 
 	for j := range g_jobQueue { // consume the messages in the queue
-		crc, err := CRCReader(j, fileReadBuffer, fileReadBufferSize)
+		crc, processedSize, chunks, err := CRCReader(j, fileReadBuffer, fileReadBufferSize)
+
+		if g_verifyEnabled {
+			verifyResult(j, crc, processedSize, err)
+			jobStats[jobId].bytesProcessed.Add(processedSize)
+			jobStats[jobId].filesProcessed.Add(1)
+			continue
+		}
+
 		if err != nil {
 			printErr(j.path, err)
 			continue
 		}
 		batchCounter++
 		jobFileSize := j.size
-		localJobStat.bytesProcessed += jobFileSize
+		jobStats[jobId].bytesProcessed.Add(jobFileSize)
+		jobStats[jobId].filesProcessed.Add(1)
 
-		stdoutBuffer.WriteString(crc + fmt.Sprintf(" %016x ", jobFileSize) + j.path + "\n")
+		var sizeField string
+		if g_humanSizes {
+			sizeField = " " + humanizeIBytes(jobFileSize) + " "
+		} else {
+			sizeField = fmt.Sprintf(" %016x ", jobFileSize)
+		}
+		stdoutBuffer.WriteString(crc + sizeField + j.path + "\n")
+
+		for _, c := range chunks {
+			stdoutBuffer.WriteString(fmt.Sprintf("CHUNK %s %016x %016x %s\n", c.crc, c.offset, c.length, j.path))
+		}
 
 		if batchCounter == 0 { // byte wrap-around
 			os.Stdout.Write(stdoutBuffer.Bytes())
 			stdoutBuffer.Reset()
-			localJobStat.filesProcessed += (math.MaxUint8 + 1)
 		}
 	} //for
 
 	if batchCounter > 0 {
 		os.Stdout.Write(stdoutBuffer.Bytes())
-		localJobStat.filesProcessed += int64(batchCounter)
 	}
 
-	jobStats[jobId] = localJobStat
 	return nil
 } //fileHandler()
 
@@ -144,9 +389,184 @@ func enqueueJob(path string, info os.FileInfo, err error) error {
 	return nil
 } //enqueueJob()
 
+// walkTotals tracks the running file/byte counts discovered by walkAndEnqueue
+// so reportProgress can show a live (growing) denominator while the walk is
+// still in progress, then a firm one once walked is true.
+type walkTotals struct {
+	files  atomic.Int64
+	bytes  atomic.Int64
+	walked atomic.Bool
+} //walkTotals struct
+
+// walkAndEnqueue walks args and pushes each regular file straight into
+// g_jobQueue as it's discovered (like enqueueJob), so the walk phase and the
+// hashing phase overlap instead of the walk completing before any hashing
+// starts. totals is updated as files are found and its walked flag is set
+// once the walk finishes, turning the running byte count into a firm
+// progress denominator.
+func walkAndEnqueue(args []string, totals *walkTotals) error {
+	walker := func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			nodeType := "file: "
+			if info != nil && info.Mode().IsDir() {
+				nodeType = "dir: "
+			}
+			os.Stderr.WriteString(nodeType + "error: '" + path + "': " + err.Error() + "\n")
+			return nil
+		}
+
+		fileMode := info.Mode()
+		if fileMode.IsDir() {
+			os.Stderr.WriteString("entering dir: " + path + "\n")
+			return nil
+		}
+		if !fileMode.IsRegular() {
+			os.Stderr.WriteString("ignoring: " + path + "\n")
+			return nil
+		}
+
+		totals.files.Add(1)
+		totals.bytes.Add(info.Size())
+		g_jobQueue <- job{path: path, size: info.Size()} // blocks until a worker drains the queue
+		return nil
+	}
+
+	for _, arg := range args {
+		if err := filepath.Walk(arg, walker); err != nil {
+			return err
+		}
+	} //for
+
+	return nil
+} //walkAndEnqueue()
+
+// parseVerifyFile reads a prior mass-crc32c output file (lines of
+// "BASE64CRC HEXSIZE PATH") into jobs to be re-checked against the
+// filesystem via the existing worker pool. CHUNK lines (emitted by -cdc
+// alongside each file's whole-file line) carry per-chunk, not per-file,
+// CRCs/sizes and are skipped rather than parsed as jobs.
+func parseVerifyFile(path string) ([]job, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var jobs []job
+	scanner := bufio.NewScanner(file)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "CHUNK ") {
+			continue
+		}
+
+		fields := strings.SplitN(line, " ", 3)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("verify: %s:%d: malformed line: %q", path, lineNum, line)
+		}
+
+		size, err := strconv.ParseInt(fields[1], 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("verify: %s:%d: bad size %q: %w", path, lineNum, fields[1], err)
+		}
+
+		jobs = append(jobs, job{path: fields[2], size: size, expectedCRC: fields[0]})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+} //parseVerifyFile()
+
+// produceJobs feeds pre-collected jobs (the parsed -verify manifest) into
+// g_jobQueue and closes it once every job has been sent, so workers start
+// hashing while the rest of the jobs are still being queued.
+func produceJobs(jobs []job) {
+	for _, j := range jobs {
+		g_jobQueue <- j
+	}
+	close(g_jobQueue)
+} //produceJobs()
+
+// reportProgress redraws a single-line stderr status roughly every 250ms:
+// files/bytes processed and a short moving-average throughput. totals.files/
+// totals.bytes grow as walkAndEnqueue discovers more of the tree, so the
+// denominator they show is only a running count until totals.walked is set,
+// at which point percentage complete and ETA become available. Once done is
+// closed, reportProgress does one final render and then closes stopped, so
+// a caller can wait on stopped before clearing the progress line instead of
+// racing this goroutine's own shutdown.
+func reportProgress(jobStats []jobStat, totals *walkTotals, done <-chan struct{}, stopped chan<- struct{}) {
+	defer close(stopped)
+
+	const tickInterval = 250 * time.Millisecond
+	const movingAverageTicks = 8 // ~2s moving average window
+
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+
+	type sample struct {
+		when  time.Time
+		bytes int64
+	}
+	history := make([]sample, 0, movingAverageTicks)
+
+	render := func() {
+		var filesProcessed, bytesProcessed int64
+		for i := range jobStats {
+			filesProcessed += jobStats[i].filesProcessed.Load()
+			bytesProcessed += jobStats[i].bytesProcessed.Load()
+		}
+		totalFiles, totalBytes, walked := totals.files.Load(), totals.bytes.Load(), totals.walked.Load()
+
+		now := time.Now()
+		history = append(history, sample{now, bytesProcessed})
+		if len(history) > movingAverageTicks {
+			history = history[len(history)-movingAverageTicks:]
+		}
+
+		var mibPerSecond float64
+		if oldest := history[0]; now.After(oldest.when) {
+			if elapsed := now.Sub(oldest.when).Seconds(); elapsed > 0 {
+				mibPerSecond = float64(bytesProcessed-oldest.bytes) / (1024 * 1024) / elapsed
+			}
+		}
+
+		line := fmt.Sprintf("[Files: %d/%d] [Bytes: %s] [%.2f MiB/s]",
+			filesProcessed, totalFiles, strings.TrimSpace(humanizeIBytes(bytesProcessed)), mibPerSecond)
+
+		if !walked {
+			line += " [walking...]"
+		} else if totalBytes > 0 {
+			percent := float64(bytesProcessed) / float64(totalBytes) * 100
+			line += fmt.Sprintf(" [%.1f%%]", percent)
+
+			if mibPerSecond > 0 {
+				remaining := float64(totalBytes-bytesProcessed) / (1024 * 1024) / mibPerSecond
+				eta := time.Duration(remaining * float64(time.Second)).Round(time.Second)
+				line += fmt.Sprintf(" [ETA: %v]", eta)
+			}
+		}
+
+		writeProgressLine(line)
+	}
+
+	for {
+		select {
+		case <-ticker.C:
+			render()
+		case <-done:
+			render() // final redraw so the line reflects the finished state
+			return
+		}
+	}
+} //reportProgress()
+
 func init() {
 	g_crc32cTable = crc32.MakeTable(crc32.Castagnoli)
 	sanityCheck()
+	combineSanityCheck()
 }
 
 func sanityCheck() {
@@ -174,6 +594,7 @@ func main() {
 	var workerCount int
 	var bufferSizeKB int
 	var listAheadSize int
+	var verifyFilePath string
 
 	const DEFAULT_BUFFER_SIZE_KB = 1024
 	numCPU := runtime.NumCPU()
@@ -182,10 +603,23 @@ func main() {
 	flag.IntVar(&workerCount, "j", numCPU*4, "# of parallel reads")
 	flag.IntVar(&listAheadSize, "l", workerCount, "size of list ahead queue")
 	flag.IntVar(&bufferSizeKB, "s", DEFAULT_BUFFER_SIZE_KB, "size of reads in kbytes")
+	flag.BoolVar(&g_humanSizes, "h", false, "human-readable sizes and throughput (IEC units)")
+	flag.BoolVar(&g_progressEnabled, "progress", false, "show live progress on stderr (files/bytes/s, ETA)")
+	flag.StringVar(&verifyFilePath, "verify", "", "verify files against a prior mass-crc32c output file (BASE64CRC HEXSIZE PATH lines), instead of hashing path arguments")
+	flag.IntVar(&g_splitParts, "split", 1, "split files at least -split-min bytes into N parallel CRC32C ranges, combined via CRC32C-combine; 1 disables splitting")
+	flag.Int64Var(&g_splitMinBytes, "split-min", 256*1024*1024, "minimum file size in bytes to trigger -split chunked hashing")
+	flag.BoolVar(&g_cdcEnabled, "cdc", false, "also emit content-defined chunk CRC32Cs (CHUNK lines) for dedup/delta-sync manifests")
 	flag.Usage = printUsage
 
 	flag.Parse()
-	if flag.NArg() == 0 {
+	g_verifyEnabled = verifyFilePath != ""
+	if g_verifyEnabled && g_progressEnabled {
+		log.Fatal("-progress is not supported together with -verify")
+	}
+	if g_cdcEnabled && g_splitParts > 1 {
+		log.Fatal("-split is not supported together with -cdc")
+	}
+	if !g_verifyEnabled && flag.NArg() == 0 {
 		fmt.Fprintln(os.Stderr, "error: missing paths")
 		printUsage()
 		os.Exit(1)
@@ -212,28 +646,72 @@ func main() {
 		}(jobId)
 	}
 
-	// enqueue jobs
-	for _, arg := range flag.Args() {
-		err := filepath.Walk(arg, enqueueJob)
+	if g_verifyEnabled {
+		jobs, err := parseVerifyFile(verifyFilePath)
 		if err != nil {
 			log.Fatal(err)
 		}
-	} //for
-	close(g_jobQueue) // safe to close, since all jobs have already been channel-received by now
 
-	waitGroup.Wait()
+		go produceJobs(jobs)
+		waitGroup.Wait()
+	} else if g_progressEnabled {
+		totals := &walkTotals{}
+		done := make(chan struct{})
+		stopped := make(chan struct{})
+		go reportProgress(jobStats, totals, done, stopped)
+		go func() {
+			if err := walkAndEnqueue(flag.Args(), totals); err != nil {
+				log.Fatal(err)
+			}
+			totals.walked.Store(true)
+			close(g_jobQueue) // safe to close, since all jobs have already been channel-received by now
+		}()
+
+		waitGroup.Wait()
+		close(done)
+		<-stopped // wait for reportProgress's final render before clearing its line
+
+		g_progressMutex.Lock()
+		clearProgressLineLocked()
+		g_progressMutex.Unlock()
+	} else {
+		// enqueue jobs
+		for _, arg := range flag.Args() {
+			err := filepath.Walk(arg, enqueueJob)
+			if err != nil {
+				log.Fatal(err)
+			}
+		} //for
+		close(g_jobQueue) // safe to close, since all jobs have already been channel-received by now
+
+		waitGroup.Wait()
+	}
 	duration := time.Since(start)
 
 	var totalFilesProcessed, totalBytesProcessed int64
-	for _, item := range jobStats {
-		totalFilesProcessed += item.filesProcessed
-		totalBytesProcessed += item.bytesProcessed
+	for i := range jobStats {
+		totalFilesProcessed += jobStats[i].filesProcessed.Load()
+		totalBytesProcessed += jobStats[i].bytesProcessed.Load()
 	}
 
-	var mbPerSecond float64 = (float64(totalBytesProcessed) / (1024 * 1024)) / duration.Seconds()
 	printer := message.NewPrinter(language.English)
 
 	printFlags()
-	printer.Fprintf(os.Stderr, "[Duration: %v] [Files processed: %v] [Bytes processed: %v] [%.2f MiB/second]\n",
-		duration, totalFilesProcessed, totalBytesProcessed, mbPerSecond)
+	if g_humanSizes {
+		bytesPerSecond := int64(float64(totalBytesProcessed) / duration.Seconds())
+		printer.Fprintf(os.Stderr, "[Duration: %v] [Files processed: %v] [Bytes processed: %s] [%s/second]\n",
+			duration, totalFilesProcessed, strings.TrimSpace(humanizeIBytes(totalBytesProcessed)), strings.TrimSpace(humanizeIBytes(bytesPerSecond)))
+	} else {
+		var mbPerSecond float64 = (float64(totalBytesProcessed) / (1024 * 1024)) / duration.Seconds()
+		printer.Fprintf(os.Stderr, "[Duration: %v] [Files processed: %v] [Bytes processed: %v] [%.2f MiB/second]\n",
+			duration, totalFilesProcessed, totalBytesProcessed, mbPerSecond)
+	}
+
+	if g_verifyEnabled {
+		okCount, failedCount, missingCount := g_verifyOK.Load(), g_verifyFailed.Load(), g_verifyMissing.Load()
+		printer.Fprintf(os.Stderr, "[Verify OK: %v] [Failed: %v] [Missing: %v]\n", okCount, failedCount, missingCount)
+		if failedCount > 0 || missingCount > 0 {
+			os.Exit(1)
+		}
+	}
 } //main()
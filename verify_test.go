@@ -0,0 +1,157 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+// captureStderr redirects os.Stderr for the duration of fn and returns
+// whatever was written to it.
+func captureStderr(t *testing.T, fn func()) string {
+	t.Helper()
+
+	orig := os.Stderr
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stderr = w
+
+	fn()
+
+	w.Close()
+	os.Stderr = orig
+
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out)
+}
+
+func resetVerifyCounters() {
+	g_verifyOK.Store(0)
+	g_verifyFailed.Store(0)
+	g_verifyMissing.Store(0)
+}
+
+func TestVerifyResultMissing(t *testing.T) {
+	resetVerifyCounters()
+	j := job{path: "does/not/exist", expectedCRC: "abcd"}
+
+	out := captureStderr(t, func() {
+		verifyResult(j, "", 0, os.ErrNotExist)
+	})
+
+	if !strings.Contains(out, "MISSING: does/not/exist\n") {
+		t.Fatalf("output = %q, want a MISSING line", out)
+	}
+	if ok, failed, missing := g_verifyOK.Load(), g_verifyFailed.Load(), g_verifyMissing.Load(); ok != 0 || failed != 0 || missing != 1 {
+		t.Fatalf("counters = ok:%d failed:%d missing:%d, want 0/0/1", ok, failed, missing)
+	}
+}
+
+func TestVerifyResultSizeMismatch(t *testing.T) {
+	resetVerifyCounters()
+	j := job{path: "somefile", size: 100, expectedCRC: "abcd"}
+
+	out := captureStderr(t, func() {
+		verifyResult(j, "", 42, errSizeMismatch)
+	})
+
+	if !strings.Contains(out, "SIZE: somefile") {
+		t.Fatalf("output = %q, want a SIZE line", out)
+	}
+	if failed := g_verifyFailed.Load(); failed != 1 {
+		t.Fatalf("g_verifyFailed = %d, want 1", failed)
+	}
+}
+
+func TestVerifyResultReadError(t *testing.T) {
+	resetVerifyCounters()
+	j := job{path: "somefile", expectedCRC: "abcd"}
+
+	out := captureStderr(t, func() {
+		verifyResult(j, "", 0, errors.New("permission denied"))
+	})
+
+	if !strings.Contains(out, "CRC error: 'somefile' : permission denied") {
+		t.Fatalf("output = %q, want a CRC error line", out)
+	}
+	if failed := g_verifyFailed.Load(); failed != 1 {
+		t.Fatalf("g_verifyFailed = %d, want 1", failed)
+	}
+}
+
+func TestVerifyResultMismatch(t *testing.T) {
+	resetVerifyCounters()
+	j := job{path: "somefile", expectedCRC: "AAAA"}
+
+	out := captureStderr(t, func() {
+		verifyResult(j, "BBBB", 0, nil)
+	})
+
+	if !strings.Contains(out, "MISMATCH: somefile (expected AAAA, got BBBB)") {
+		t.Fatalf("output = %q, want a MISMATCH line", out)
+	}
+	if failed := g_verifyFailed.Load(); failed != 1 {
+		t.Fatalf("g_verifyFailed = %d, want 1", failed)
+	}
+}
+
+func TestVerifyResultOK(t *testing.T) {
+	resetVerifyCounters()
+	j := job{path: "somefile", expectedCRC: "AAAA"}
+
+	out := captureStderr(t, func() {
+		verifyResult(j, "AAAA", 123, nil)
+	})
+
+	if out != "" {
+		t.Fatalf("output = %q, want no output on a clean match", out)
+	}
+	if ok := g_verifyOK.Load(); ok != 1 {
+		t.Fatalf("g_verifyOK = %d, want 1", ok)
+	}
+}
+
+func TestParseVerifyFileSkipsChunkLines(t *testing.T) {
+	dir := t.TempDir()
+	path := dir + "/manifest.txt"
+	const manifest = "AAAAAA== 0000000000000010 a.bin\n" +
+		"CHUNK BBBBBB== 0000000000000000 0000000000000010 a.bin\n" +
+		"CCCCCC== 0000000000000020 b.bin\n"
+	if err := os.WriteFile(path, []byte(manifest), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	jobs, err := parseVerifyFile(path)
+	if err != nil {
+		t.Fatalf("parseVerifyFile: %v", err)
+	}
+	if len(jobs) != 2 {
+		t.Fatalf("len(jobs) = %d, want 2 (CHUNK line should be skipped)", len(jobs))
+	}
+	if jobs[0].path != "a.bin" || jobs[1].path != "b.bin" {
+		t.Fatalf("jobs = %+v, want a.bin then b.bin", jobs)
+	}
+}
+
+func TestParseVerifyFileBadSize(t *testing.T) {
+	dir := t.TempDir()
+	path := dir + "/manifest.txt"
+	if err := os.WriteFile(path, []byte("AAAAAA== nothex a.bin\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := parseVerifyFile(path)
+	if err == nil {
+		t.Fatal("parseVerifyFile: want an error for a non-hex size field, got nil")
+	}
+	if strings.Contains(err.Error(), "-h") {
+		t.Fatalf("error = %q, should not guess -h as the cause", err.Error())
+	}
+}